@@ -0,0 +1,170 @@
+/*
+Original work from https://github.com/DealerDotCom/terraform-provider-bigip
+Modifications Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceBigipLtmNode() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBigipLtmNodeRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the node",
+			},
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Common",
+				Description: "Partition the node is part of",
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Address of the node",
+			},
+			"rate_limit": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Specifies the maximum number of connections per second allowed for a node or node address. The default value is 'disabled'.",
+			},
+			"connection_limit": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Specifies the maximum number of connections allowed for the node or node address.",
+			},
+			"dynamic_ratio": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Sets the dynamic ratio number for the node. Used for dynamic ratio load balancing. ",
+			},
+			"ratio": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Sets the ratio number for the node.",
+			},
+			"monitor": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Specifies the name of the monitor or monitor rule that you want to associate with the node.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "User defined description of the node.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Marks the node up or down. The default value is user-up.",
+			},
+			"session": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Enables or disables the node for new sessions. The default value is user-enabled.",
+			},
+			"fqdn": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address_family": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Specifies the node's address family. The default is 'unspecified', or IP-agnostic",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Specifies the fully qualified domain name of the node.",
+						},
+						"interval": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Specifies the amount of time before sending the next DNS query.",
+						},
+						"downinterval": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Specifies the number of attempts to resolve a domain name. The default is 5.",
+						},
+						"autopopulate": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Specifies whether the node should scale to the IP address set returned by DNS.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBigipLtmNodeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+
+	name := d.Get("name").(string)
+	partition := d.Get("partition").(string)
+	fullName := fmt.Sprintf("/%s/%s", partition, name)
+
+	node, err := client.GetNode(fullName)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error retrieving node %s: %v", fullName, err))
+	}
+	if node == nil {
+		return diag.FromErr(fmt.Errorf("node %s not found", fullName))
+	}
+
+	d.SetId(fullName)
+
+	if node.FQDN.Name != "" {
+		if err := d.Set("address", node.FQDN.Name); err != nil {
+			return diag.FromErr(fmt.Errorf("[DEBUG] Error saving address to state for Node (%s): %s", d.Id(), err))
+		}
+	} else {
+		if err := d.Set("address", node.Address); err != nil {
+			return diag.FromErr(fmt.Errorf("[DEBUG] Error saving address to state for Node (%s): %s", d.Id(), err))
+		}
+	}
+
+	if (node.Session == "monitor-enabled") || (node.Session == "user-enabled") {
+		_ = d.Set("session", "user-enabled")
+	} else {
+		_ = d.Set("session", "user-disabled")
+	}
+
+	_ = d.Set("rate_limit", node.RateLimit)
+	_ = d.Set("connection_limit", node.ConnectionLimit)
+	_ = d.Set("description", node.Description)
+	_ = d.Set("dynamic_ratio", node.DynamicRatio)
+	_ = d.Set("monitor", strings.TrimSpace(node.Monitor))
+	_ = d.Set("ratio", node.Ratio)
+	_ = d.Set("state", node.State)
+
+	fqdn := map[string]interface{}{
+		"address_family": node.FQDN.AddressFamily,
+		"name":           node.FQDN.Name,
+		"interval":       node.FQDN.Interval,
+		"downinterval":   node.FQDN.DownInterval,
+		"autopopulate":   node.FQDN.AutoPopulate,
+	}
+	if err := d.Set("fqdn", []interface{}{fqdn}); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving fqdn to state for Node (%s): %s", d.Id(), err))
+	}
+
+	return nil
+}
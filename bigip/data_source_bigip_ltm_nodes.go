@@ -0,0 +1,236 @@
+/*
+Original work from https://github.com/DealerDotCom/terraform-provider-bigip
+Modifications Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+
+	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceBigipLtmNodes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBigipLtmNodesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "A regular expression (RE2 syntax) used to filter node names",
+				ValidateFunc: validateRegexp,
+			},
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only consider nodes in this partition",
+			},
+			"address_cidr": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only consider nodes whose resolved address falls within this CIDR",
+			},
+			"monitor": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only consider nodes whose monitor matches this value",
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the nodes matching the filters, sorted lexically",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"nodes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Nodes matching the filters",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rate_limit": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"connection_limit": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"dynamic_ratio": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"ratio": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"monitor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"session": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fqdn": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address_family": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"interval": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"downinterval": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"autopopulate": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func fnvHash(s string) uint32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(s))
+	return h.Sum32()
+}
+
+func validateRegexp(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := regexp.Compile(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid regular expression: %v", k, err))
+	}
+	return
+}
+
+func dataSourceBigipLtmNodesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*bigip.BigIP)
+
+	nameRegex, err := regexp.Compile(d.Get("name_regex").(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("invalid name_regex: %v", err))
+	}
+	partition := d.Get("partition").(string)
+	monitorFilter := d.Get("monitor").(string)
+
+	var cidr *net.IPNet
+	if v, ok := d.GetOk("address_cidr"); ok {
+		_, parsed, err := net.ParseCIDR(v.(string))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("invalid address_cidr: %v", err))
+		}
+		cidr = parsed
+	}
+
+	nodes, err := client.Nodes()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error retrieving nodes: %v", err))
+	}
+
+	var names []string
+	var matched []map[string]interface{}
+	for _, node := range nodes.Nodes {
+		if partition != "" && node.Partition != partition {
+			continue
+		}
+		if !nameRegex.MatchString(node.Name) {
+			continue
+		}
+		if monitorFilter != "" && strings.TrimSpace(node.Monitor) != monitorFilter {
+			continue
+		}
+		if cidr != nil {
+			ip := net.ParseIP(node.Address)
+			if ip == nil || !cidr.Contains(ip) {
+				continue
+			}
+		}
+
+		address := node.Address
+		if node.FQDN.Name != "" {
+			address = node.FQDN.Name
+		}
+
+		names = append(names, node.Name)
+		matched = append(matched, map[string]interface{}{
+			"name":             node.Name,
+			"address":          address,
+			"rate_limit":       node.RateLimit,
+			"connection_limit": node.ConnectionLimit,
+			"dynamic_ratio":    node.DynamicRatio,
+			"ratio":            node.Ratio,
+			"monitor":          strings.TrimSpace(node.Monitor),
+			"description":      node.Description,
+			"state":            node.State,
+			"session":          node.Session,
+			"fqdn": []interface{}{
+				map[string]interface{}{
+					"address_family": node.FQDN.AddressFamily,
+					"name":           node.FQDN.Name,
+					"interval":       node.FQDN.Interval,
+					"downinterval":   node.FQDN.DownInterval,
+					"autopopulate":   node.FQDN.AutoPopulate,
+				},
+			},
+		})
+	}
+
+	sort.Strings(names)
+	sort.Slice(matched, func(i, j int) bool {
+		return matched[i]["name"].(string) < matched[j]["name"].(string)
+	})
+
+	d.SetId(fmt.Sprintf("%x", fnvHash(strings.Join(names, ","))))
+
+	if err := d.Set("names", names); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving names to state: %s", err))
+	}
+	if err := d.Set("nodes", matched); err != nil {
+		return diag.FromErr(fmt.Errorf("[DEBUG] Error saving nodes to state: %s", err))
+	}
+
+	return nil
+}
@@ -7,15 +7,22 @@ If a copy of the MPL was not distributed with this file,You can obtain one at ht
 package bigip
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"net"
+	"net/netip"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"context"
 	bigip "github.com/f5devcentral/go-bigip"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceBigipLtmNode() *schema.Resource {
@@ -37,10 +44,12 @@ func resourceBigipLtmNode() *schema.Resource {
 				ValidateFunc: validateF5Name,
 			},
 			"address": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Address of the node",
-				ForceNew:    true,
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Address of the node. Accepts an IPv4/IPv6 literal, optionally suffixed with `%<route-domain>`, or an RFC 1123 DNS name for FQDN nodes.",
+				ForceNew:         true,
+				ValidateDiagFunc: validateNodeAddress,
+				DiffSuppressFunc: suppressAddressDiff,
 			},
 			"rate_limit": {
 				Type:        schema.TypeString,
@@ -67,10 +76,43 @@ func resourceBigipLtmNode() *schema.Resource {
 				Computed:    true,
 			},
 			"monitor": {
-				Type:        schema.TypeString,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Specifies the name of the monitor, or monitor rule, that you want to associate with the node. When `monitors` is set, this is computed from `monitors` and `availability_requirement` instead.",
+				DiffSuppressFunc: suppressMonitorRuleDiff,
+			},
+			"monitors": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				Computed:         true,
+				Description:      "Specifies the health monitors the node should use, combined per `availability_requirement` into the `monitor` rule sent to BIG-IP.",
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				DiffSuppressFunc: suppressMonitorsListDiff,
+			},
+			"availability_requirement": {
+				Type:        schema.TypeList,
 				Optional:    true,
-				Default:     "/Common/icmp",
-				Description: "Specifies the name of the monitor or monitor rule that you want to associate with the node.",
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Specifies how many of the `monitors` must report the node as up for it to be considered available.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							Description:  "`all` requires every monitor in `monitors` to pass, `at_least` requires the number given in `at_least` to pass.",
+							ValidateFunc: validation.StringInSlice([]string{"all", "at_least"}, false),
+						},
+						"at_least": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "Number of `monitors` that must pass when `type` is `at_least`.",
+						},
+					},
+				},
 			},
 			"description": {
 				Type:        schema.TypeString,
@@ -96,9 +138,10 @@ func resourceBigipLtmNode() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"address_family": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "Specifies the node's address family. The default is 'unspecified', or IP-agnostic",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Specifies the node's address family. The default is 'unspecified', or IP-agnostic",
+							ValidateFunc: validation.StringInSlice([]string{"ipv4", "ipv6", "all", "unspecified"}, false),
 						},
 						"name": {
 							Type:        schema.TypeString,
@@ -123,13 +166,315 @@ func resourceBigipLtmNode() *schema.Resource {
 							Computed:    true,
 							Description: "Specifies whether the node should scale to the IP address set returned by DNS.",
 						},
+						"min_addresses": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Minimum number of resolved addresses (across both families) required for the apply to succeed. 0 disables the check.",
+						},
 					},
 				},
 			},
+			"resolved_ipv4_addresses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IPv4 addresses currently resolved for an `fqdn` node, sorted lexically.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"resolved_ipv6_addresses": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IPv6 addresses currently resolved for an `fqdn` node, sorted lexically.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
 
+// buildMonitorRule renders the monitors/availability_requirement attributes into the
+// Monitor string BIG-IP expects, e.g. "min 2 of { /Common/http /Common/https }".
+// With a single monitor and no availability_requirement it falls back to the plain
+// monitor name for backward compatibility with the existing `monitor` attribute.
+func buildMonitorRule(monitors []string, availabilityType string, atLeast int) string {
+	if len(monitors) == 0 {
+		return ""
+	}
+	if len(monitors) == 1 && availabilityType != "at_least" {
+		return monitors[0]
+	}
+	if availabilityType == "at_least" {
+		return fmt.Sprintf("min %d of { %s }", atLeast, strings.Join(monitors, " "))
+	}
+	return strings.Join(monitors, " and ")
+}
+
+var monitorRuleRegex = regexp.MustCompile(`^min\s+(\d+)\s+of\s+\{\s*(.*?)\s*\}$`)
+
+// parseMonitorRule recognizes both the "min N of { ... }" rule syntax and the
+// existing single monitor name form, returning the individual monitors and the
+// equivalent availability_requirement.
+func parseMonitorRule(rule string) (monitors []string, availabilityType string, atLeast int) {
+	rule = strings.TrimSpace(rule)
+	if rule == "" {
+		return nil, "all", 0
+	}
+	if m := monitorRuleRegex.FindStringSubmatch(rule); m != nil {
+		monitors = strings.Fields(m[2])
+		atLeast, _ = strconv.Atoi(m[1])
+		return monitors, "at_least", atLeast
+	}
+	if strings.Contains(rule, " and ") {
+		monitors = strings.Split(rule, " and ")
+		for i := range monitors {
+			monitors[i] = strings.TrimSpace(monitors[i])
+		}
+		return monitors, "all", 0
+	}
+	return []string{rule}, "all", 0
+}
+
+// normalizeMonitorRule canonicalizes a monitor rule so that equivalent rules with
+// reordered monitors or different whitespace compare equal.
+func normalizeMonitorRule(rule string) string {
+	monitors, availabilityType, atLeast := parseMonitorRule(rule)
+	sorted := append([]string(nil), monitors...)
+	sort.Strings(sorted)
+	return buildMonitorRule(sorted, availabilityType, atLeast)
+}
+
+func suppressMonitorRuleDiff(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeMonitorRule(old) == normalizeMonitorRule(new)
+}
+
+// suppressMonitorsListDiff ignores reordering of the monitors list: BIG-IP may
+// return a monitor rule's component monitors in a different order than they
+// were configured in, which would otherwise churn every element of the list.
+func suppressMonitorsListDiff(k, old, new string, d *schema.ResourceData) bool {
+	oldRaw, newRaw := d.GetChange("monitors")
+	return sortedStrings(oldRaw) == sortedStrings(newRaw)
+}
+
+// sortedStrings renders a monitors-shaped []interface{} as a sorted,
+// comma-joined string for order-independent comparison.
+func sortedStrings(raw interface{}) string {
+	list, ok := raw.([]interface{})
+	if !ok {
+		return ""
+	}
+	values := make([]string, 0, len(list))
+	for _, v := range list {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		values = append(values, s)
+	}
+	sort.Strings(values)
+	return strings.Join(values, ",")
+}
+
+func expandMonitorRule(d *schema.ResourceData) string {
+	rawMonitors := d.Get("monitors").([]interface{})
+	if len(rawMonitors) == 0 {
+		return d.Get("monitor").(string)
+	}
+	monitors := make([]string, len(rawMonitors))
+	for i, m := range rawMonitors {
+		monitors[i] = m.(string)
+	}
+
+	availabilityType := "all"
+	atLeast := 0
+	if v, ok := d.GetOk("availability_requirement"); ok {
+		reqs := v.([]interface{})
+		if len(reqs) > 0 {
+			req := reqs[0].(map[string]interface{})
+			availabilityType = req["type"].(string)
+			atLeast = req["at_least"].(int)
+		}
+	}
+
+	return buildMonitorRule(monitors, availabilityType, atLeast)
+}
+
+// splitRouteDomain separates a BIG-IP "%<route-domain>" suffix from the address
+// literal or DNS name that precedes it.
+func splitRouteDomain(address string) (base string, zone string) {
+	if idx := strings.LastIndex(address, "%"); idx != -1 {
+		return address[:idx], address[idx+1:]
+	}
+	return address, ""
+}
+
+var (
+	dnsLabelRegex  = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+	allDigitsRegex = regexp.MustCompile(`^[0-9]+$`)
+)
+
+// isValidDNSName reports whether name is a syntactically valid RFC 1123 DNS name.
+// A name whose every label is all-digits is rejected, since that's the hallmark
+// of a malformed IP literal (e.g. "999.999.999.999") rather than a real hostname.
+func isValidDNSName(name string) bool {
+	if name == "" || len(name) > 253 {
+		return false
+	}
+	allDigits := true
+	for _, label := range strings.Split(name, ".") {
+		if !dnsLabelRegex.MatchString(label) {
+			return false
+		}
+		if !allDigitsRegex.MatchString(label) {
+			allDigits = false
+		}
+	}
+	return !allDigits
+}
+
+// isIPLiteral reports whether address (after stripping any %route-domain suffix)
+// parses as an IP literal, as opposed to an FQDN node's DNS name.
+func isIPLiteral(address string) bool {
+	base, _ := splitRouteDomain(address)
+	_, err := netip.ParseAddr(base)
+	return err == nil
+}
+
+// validateNodeAddress accepts either an IP literal (with an optional
+// %route-domain suffix) or an RFC 1123 DNS name.
+func validateNodeAddress(v interface{}, p cty.Path) diag.Diagnostics {
+	address, ok := v.(string)
+	if !ok {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "invalid address",
+			Detail:        "address must be a string",
+			AttributePath: p,
+		}}
+	}
+
+	base, _ := splitRouteDomain(address)
+	if _, err := netip.ParseAddr(base); err == nil || isValidDNSName(base) {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       "invalid address",
+		Detail:        fmt.Sprintf("%q is not a valid IP literal (with optional %%route-domain suffix) or RFC 1123 DNS name", address),
+		AttributePath: p,
+	}}
+}
+
+// normalizeNodeAddress canonicalizes an IP literal (compressed IPv6, no leading
+// zeros in IPv4) while leaving any %route-domain suffix and DNS names untouched.
+func normalizeNodeAddress(address string) string {
+	base, zone := splitRouteDomain(address)
+	ip, err := netip.ParseAddr(base)
+	if err != nil {
+		// netip rejects IPv4 octets with leading zeros, so strip them first.
+		octets := strings.Split(base, ".")
+		if len(octets) != 4 {
+			return address
+		}
+		for i, octet := range octets {
+			n, convErr := strconv.Atoi(octet)
+			if !allDigitsRegex.MatchString(octet) || convErr != nil || n > 255 {
+				return address
+			}
+			octets[i] = strconv.Itoa(n)
+		}
+		if ip, err = netip.ParseAddr(strings.Join(octets, ".")); err != nil {
+			return address
+		}
+	}
+	if zone != "" {
+		return ip.String() + "%" + zone
+	}
+	return ip.String()
+}
+
+func suppressAddressDiff(k, old, new string, d *schema.ResourceData) bool {
+	return normalizeNodeAddress(old) == normalizeNodeAddress(new)
+}
+
+// ephemeralNode is the subset of the ltm/node collection response that
+// go-bigip's Node type doesn't expose: the fields BIG-IP only sets on the
+// ephemeral child nodes it creates under an FQDN parent.
+type ephemeralNode struct {
+	Address       string `json:"address"`
+	Ephemeral     string `json:"ephemeral"`
+	FQDNEphemeral string `json:"fqdnEphemeral"`
+}
+
+type ephemeralNodeCollection struct {
+	Items []ephemeralNode `json:"items"`
+}
+
+// partitionFromFullPath returns the partition component of a "/Partition/Name"
+// full path.
+func partitionFromFullPath(fullPath string) string {
+	trimmed := strings.TrimPrefix(fullPath, "/")
+	if idx := strings.Index(trimmed, "/"); idx != -1 {
+		return trimmed[:idx]
+	}
+	return trimmed
+}
+
+// resolveFQDNAddresses lists the ephemeral child nodes BIG-IP creates under an
+// FQDN parent node and splits their resolved addresses by family. parent is the
+// full path (e.g. "/Common/node1") of the FQDN node. The ephemeral/fqdnEphemeral
+// fields aren't modeled on go-bigip's Node type, so this issues a raw iControl
+// REST call instead of going through client.Nodes().
+func resolveFQDNAddresses(client *bigip.BigIP, parent string) (ipv4 []string, ipv6 []string, err error) {
+	partition := partitionFromFullPath(parent)
+	url := fmt.Sprintf("ltm/node?$filter=partition+eq+%s&expandSubcollections=true", partition)
+
+	data, err := client.APICall(&bigip.APIRequest{
+		Method: "get",
+		URL:    url,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var collection ephemeralNodeCollection
+	if err := json.Unmarshal(data, &collection); err != nil {
+		return nil, nil, fmt.Errorf("error parsing node collection: %v", err)
+	}
+
+	for _, n := range collection.Items {
+		if n.Ephemeral != "true" || n.FQDNEphemeral != parent {
+			continue
+		}
+		ip := net.ParseIP(n.Address)
+		if ip == nil {
+			continue
+		}
+		if ip.To4() != nil {
+			ipv4 = append(ipv4, ip.String())
+		} else {
+			ipv6 = append(ipv6, ip.String())
+		}
+	}
+	sort.Strings(ipv4)
+	sort.Strings(ipv6)
+	return ipv4, ipv6, nil
+}
+
+// enforceMinAddresses fails an apply if fewer than fqdn.0.min_addresses addresses
+// resolved. It is only called from Create/Update, not Read, so a transient DNS
+// blip on a later refresh doesn't brick a node that's already in state.
+func enforceMinAddresses(d *schema.ResourceData) diag.Diagnostics {
+	minAddresses := d.Get("fqdn.0.min_addresses").(int)
+	if minAddresses <= 0 {
+		return nil
+	}
+
+	resolved := len(d.Get("resolved_ipv4_addresses").([]interface{})) + len(d.Get("resolved_ipv6_addresses").([]interface{}))
+	if resolved < minAddresses {
+		return diag.FromErr(fmt.Errorf("node %s resolved %d address(es), want at least %d", d.Id(), resolved, minAddresses))
+	}
+	return nil
+}
+
 func resourceBigipLtmNodeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*bigip.BigIP)
 
@@ -138,14 +483,15 @@ func resourceBigipLtmNodeCreate(ctx context.Context, d *schema.ResourceData, met
 	rateLimit := d.Get("rate_limit").(string)
 	connectionLimit := d.Get("connection_limit").(int)
 	dynamicRatio := d.Get("dynamic_ratio").(int)
-	monitor := d.Get("monitor").(string)
+	monitor := expandMonitorRule(d)
+	if monitor == "" {
+		monitor = "/Common/icmp"
+	}
 	state := d.Get("state").(string)
 	session := d.Get("session").(string)
 	description := d.Get("description").(string)
 	ratio := d.Get("ratio").(int)
 
-	r := regexp.MustCompile("^((?:[0-9]{1,3}.){3}[0-9]{1,3})|(.*:[^%]*)$")
-
 	log.Println("[INFO] Creating node " + name + "::" + address)
 
 	nodeConfig := &bigip.Node{
@@ -160,7 +506,7 @@ func resourceBigipLtmNodeCreate(ctx context.Context, d *schema.ResourceData, met
 		Ratio:           ratio,
 	}
 
-	if r.MatchString(address) {
+	if isIPLiteral(address) {
 		nodeConfig.Address = address
 	} else {
 		interval := d.Get("fqdn.0.interval").(string)
@@ -185,7 +531,12 @@ func resourceBigipLtmNodeCreate(ctx context.Context, d *schema.ResourceData, met
 			return diag.FromErr(fmt.Errorf("error modifying node %s: %v", name, err))
 		}
 	}
-	return resourceBigipLtmNodeRead(ctx, d, meta)
+
+	diags := resourceBigipLtmNodeRead(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, enforceMinAddresses(d)...)
 }
 
 func resourceBigipLtmNodeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -236,13 +587,31 @@ func resourceBigipLtmNodeRead(ctx context.Context, d *schema.ResourceData, meta
 	_ = d.Set("connection_limit", node.ConnectionLimit)
 	_ = d.Set("description", node.Description)
 	_ = d.Set("dynamic_ratio", node.DynamicRatio)
-	_ = d.Set("monitor", strings.TrimSpace(node.Monitor))
+	monitorRule := strings.TrimSpace(node.Monitor)
+	_ = d.Set("monitor", monitorRule)
+	monitors, availabilityType, atLeast := parseMonitorRule(monitorRule)
+	_ = d.Set("monitors", monitors)
+	_ = d.Set("availability_requirement", []interface{}{
+		map[string]interface{}{
+			"type":     availabilityType,
+			"at_least": atLeast,
+		},
+	})
 	_ = d.Set("ratio", node.Ratio)
 	_ = d.Set("fqdn.0.interval", node.FQDN.Interval)
 	_ = d.Set("fqdn.0.downinterval", node.FQDN.DownInterval)
 	_ = d.Set("fqdn.0.autopopulate", node.FQDN.AutoPopulate)
 	_ = d.Set("fqdn.0.address_family", node.FQDN.AddressFamily)
 
+	if node.FQDN.Name != "" {
+		ipv4Addrs, ipv6Addrs, err := resolveFQDNAddresses(client, name)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error resolving addresses for FQDN node %s: %v", name, err))
+		}
+		_ = d.Set("resolved_ipv4_addresses", ipv4Addrs)
+		_ = d.Set("resolved_ipv6_addresses", ipv6Addrs)
+	}
+
 	return nil
 }
 
@@ -270,12 +639,11 @@ func resourceBigipLtmNodeUpdate(ctx context.Context, d *schema.ResourceData, met
 
 	name := d.Id()
 	address := d.Get("address").(string)
-	r := regexp.MustCompile("^((?:[0-9]{1,3}.){3}[0-9]{1,3})|(.*:[^%]*)$")
 
 	nodeConfig := &bigip.Node{
 		ConnectionLimit: d.Get("connection_limit").(int),
 		DynamicRatio:    d.Get("dynamic_ratio").(int),
-		Monitor:         d.Get("monitor").(string),
+		Monitor:         expandMonitorRule(d),
 		RateLimit:       d.Get("rate_limit").(string),
 		State:           d.Get("state").(string),
 		Session:         d.Get("session").(string),
@@ -283,7 +651,7 @@ func resourceBigipLtmNodeUpdate(ctx context.Context, d *schema.ResourceData, met
 		Ratio:           d.Get("ratio").(int),
 	}
 
-	if r.MatchString(address) {
+	if isIPLiteral(address) {
 		nodeConfig.Address = address
 	}
 
@@ -291,7 +659,11 @@ func resourceBigipLtmNodeUpdate(ctx context.Context, d *schema.ResourceData, met
 		return diag.FromErr(fmt.Errorf("error modifying node %s: %v", name, err))
 	}
 
-	return resourceBigipLtmNodeRead(ctx, d, meta)
+	diags := resourceBigipLtmNodeRead(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, enforceMinAddresses(d)...)
 }
 
 func resourceBigipLtmNodeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
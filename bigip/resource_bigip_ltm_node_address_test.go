@@ -0,0 +1,89 @@
+/*
+Original work from https://github.com/DealerDotCom/terraform-provider-bigip
+Modifications Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import "testing"
+
+func TestIsValidDNSName(t *testing.T) {
+	cases := []struct {
+		name string
+		want bool
+	}{
+		{"node1.example.com", true},
+		{"example", true},
+		{"xn--80ak6aa92e.com", true},
+		{"", false},
+		{"999.999.999.999", false},
+		{"10", false},
+		{"-badlabel.example.com", false},
+		{"badlabel-.example.com", false},
+	}
+
+	for _, c := range cases {
+		if got := isValidDNSName(c.name); got != c.want {
+			t.Errorf("isValidDNSName(%q) = %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestIsIPLiteral(t *testing.T) {
+	cases := []struct {
+		address string
+		want    bool
+	}{
+		{"10.0.0.1", true},
+		{"2001:db8::1", true},
+		{"10.0.0.1%2", true},
+		{"999.999.999.999", false},
+		{"node1.example.com", false},
+	}
+
+	for _, c := range cases {
+		if got := isIPLiteral(c.address); got != c.want {
+			t.Errorf("isIPLiteral(%q) = %v, want %v", c.address, got, c.want)
+		}
+	}
+}
+
+func TestValidateNodeAddress(t *testing.T) {
+	cases := []struct {
+		address string
+		wantErr bool
+	}{
+		{"10.0.0.1", false},
+		{"2001:db8::1", false},
+		{"fe80::1%eth0", false},
+		{"node1.example.com", false},
+		{"999.999.999.999", true},
+	}
+
+	for _, c := range cases {
+		diags := validateNodeAddress(c.address, nil)
+		if got := diags.HasError(); got != c.wantErr {
+			t.Errorf("validateNodeAddress(%q) error = %v, want %v", c.address, got, c.wantErr)
+		}
+	}
+}
+
+func TestNormalizeNodeAddress(t *testing.T) {
+	cases := []struct {
+		address string
+		want    string
+	}{
+		{"2001:db8::0001", "2001:db8::1"},
+		{"010.000.000.001", "10.0.0.1"}, // leading zeros stripped from each octet
+		{"10.0.0.1", "10.0.0.1"},
+		{"10.0.0.1%2", "10.0.0.1%2"},
+		{"node1.example.com", "node1.example.com"},
+	}
+
+	for _, c := range cases {
+		if got := normalizeNodeAddress(c.address); got != c.want {
+			t.Errorf("normalizeNodeAddress(%q) = %q, want %q", c.address, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Original work from https://github.com/DealerDotCom/terraform-provider-bigip
+Modifications Copyright 2019 F5 Networks Inc.
+This Source Code Form is subject to the terms of the Mozilla Public License, v. 2.0.
+If a copy of the MPL was not distributed with this file,You can obtain one at https://mozilla.org/MPL/2.0/.
+*/
+package bigip
+
+import "testing"
+
+func TestBuildMonitorRule(t *testing.T) {
+	cases := []struct {
+		name             string
+		monitors         []string
+		availabilityType string
+		atLeast          int
+		want             string
+	}{
+		{"no monitors", nil, "all", 0, ""},
+		{"single monitor, all", []string{"/Common/icmp"}, "all", 0, "/Common/icmp"},
+		{"single monitor, explicit at_least 1", []string{"/Common/icmp"}, "at_least", 1, "min 1 of { /Common/icmp }"},
+		{"multiple monitors, all", []string{"/Common/http", "/Common/https"}, "all", 0, "/Common/http and /Common/https"},
+		{"multiple monitors, at_least", []string{"/Common/http", "/Common/https", "/Common/icmp"}, "at_least", 2, "min 2 of { /Common/http /Common/https /Common/icmp }"},
+	}
+
+	for _, c := range cases {
+		if got := buildMonitorRule(c.monitors, c.availabilityType, c.atLeast); got != c.want {
+			t.Errorf("%s: buildMonitorRule() = %q, want %q", c.name, got, c.want)
+		}
+	}
+}
+
+func TestParseMonitorRule(t *testing.T) {
+	cases := []struct {
+		rule             string
+		wantMonitors     []string
+		wantAvailability string
+		wantAtLeast      int
+	}{
+		{"", nil, "all", 0},
+		{"/Common/icmp", []string{"/Common/icmp"}, "all", 0},
+		{"/Common/http and /Common/https", []string{"/Common/http", "/Common/https"}, "all", 0},
+		{"min 1 of { /Common/icmp }", []string{"/Common/icmp"}, "at_least", 1},
+		{"min 2 of { /Common/http /Common/https }", []string{"/Common/http", "/Common/https"}, "at_least", 2},
+	}
+
+	for _, c := range cases {
+		monitors, availability, atLeast := parseMonitorRule(c.rule)
+		if !equalStrings(monitors, c.wantMonitors) || availability != c.wantAvailability || atLeast != c.wantAtLeast {
+			t.Errorf("parseMonitorRule(%q) = (%v, %q, %d), want (%v, %q, %d)",
+				c.rule, monitors, availability, atLeast, c.wantMonitors, c.wantAvailability, c.wantAtLeast)
+		}
+	}
+}
+
+// TestParseMonitorRulePreservesExplicitAtLeast guards against collapsing an
+// explicit "min N of { ... }" rule with N == len(monitors) down to "all",
+// which would otherwise churn a user's availability_requirement config on
+// every read.
+func TestParseMonitorRulePreservesExplicitAtLeast(t *testing.T) {
+	monitors, availability, atLeast := parseMonitorRule("min 1 of { /Common/icmp }")
+	if availability != "at_least" || atLeast != 1 || !equalStrings(monitors, []string{"/Common/icmp"}) {
+		t.Fatalf("parseMonitorRule(%q) = (%v, %q, %d), want ([/Common/icmp], \"at_least\", 1)",
+			"min 1 of { /Common/icmp }", monitors, availability, atLeast)
+	}
+}
+
+func TestNormalizeMonitorRule(t *testing.T) {
+	a := normalizeMonitorRule("min 2 of { /Common/http /Common/https }")
+	b := normalizeMonitorRule("min 2 of { /Common/https   /Common/http }")
+	if a != b {
+		t.Errorf("normalizeMonitorRule should be order-independent: %q != %q", a, b)
+	}
+}
+
+func TestSortedStrings(t *testing.T) {
+	a := sortedStrings([]interface{}{"/Common/https", "/Common/http"})
+	b := sortedStrings([]interface{}{"/Common/http", "/Common/https"})
+	if a != b {
+		t.Errorf("sortedStrings should be order-independent: %q != %q", a, b)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}